@@ -0,0 +1,109 @@
+package tokiophp
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// Client is a pooled, retrying gRPC client for a tokio_php server.
+type Client struct {
+	pool  *connPool
+	retry RetryPolicy
+}
+
+// ClientOption configures a Client at construction time.
+type ClientOption func(*clientConfig)
+
+type clientConfig struct {
+	poolSize     int
+	keepalive    keepalive.ClientParameters
+	dialOpts     []grpc.DialOption
+	retry        RetryPolicy
+	transportErr error
+}
+
+// WithPoolSize sets how many underlying *grpc.ClientConn the Client
+// round-robins RPCs across. The default is 1.
+func WithPoolSize(n int) ClientOption {
+	return func(c *clientConfig) { c.poolSize = n }
+}
+
+// WithKeepalive overrides the default keepalive ping parameters.
+func WithKeepalive(params keepalive.ClientParameters) ClientOption {
+	return func(c *clientConfig) { c.keepalive = params }
+}
+
+// WithRetryPolicy overrides the default retry policy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *clientConfig) { c.retry = policy }
+}
+
+// WithDialOption appends a raw grpc.DialOption, for transports or
+// interceptors this package doesn't wrap directly. Dial options are
+// applied in the order given, after the package's own defaults, so a
+// WithDialOption(grpc.WithTransportCredentials(...)) call overrides the
+// insecure default.
+func WithDialOption(opt grpc.DialOption) ClientOption {
+	return func(c *clientConfig) { c.dialOpts = append(c.dialOpts, opt) }
+}
+
+// NewClient dials target and returns a Client ready to make RPCs. By
+// default it connects without transport security; use WithTLS for
+// (mutual) TLS and WithTokenAuth/WithInsecureTokenAuth for bearer-token
+// auth.
+func NewClient(ctx context.Context, target string, opts ...ClientOption) (*Client, error) {
+	cfg := clientConfig{
+		poolSize:  1,
+		keepalive: defaultKeepalive,
+		retry:     defaultRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.transportErr != nil {
+		return nil, cfg.transportErr
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithKeepaliveParams(cfg.keepalive),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}
+	dialOpts = append(dialOpts, cfg.dialOpts...)
+
+	pool, err := newConnPool(target, cfg.poolSize, dialOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{pool: pool, retry: cfg.retry}, nil
+}
+
+// Execute runs a single PHP script and returns its response, retrying on
+// transient failures per the Client's RetryPolicy.
+func (c *Client) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	var resp *pb.ExecuteResponse
+	err := withRetry(ctx, c.retry, func() error {
+		stub := pb.NewPhpServiceClient(c.pool.pick())
+		r, err := stub.Execute(ctx, req)
+		if err != nil {
+			return err
+		}
+		resp = r
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tokiophp: execute %q: %w", req.GetScriptPath(), err)
+	}
+	return resp, nil
+}
+
+// Close releases all underlying connections.
+func (c *Client) Close() error {
+	return c.pool.Close()
+}
@@ -0,0 +1,183 @@
+package tokiophp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// fakePhpService is an in-memory PhpServiceServer for tests.
+type fakePhpService struct {
+	pb.UnimplementedPhpServiceServer
+
+	failures      int32 // number of leading calls to fail with failCode
+	failCode      codes.Code
+	execute       func(*pb.ExecuteRequest) (*pb.ExecuteResponse, error)
+	executeStream func(pb.PhpService_ExecuteStreamServer) error
+	tail          func(*pb.TailRequest, pb.PhpService_TailServer) error
+	callCount     int32
+}
+
+func (f *fakePhpService) ExecuteStream(stream pb.PhpService_ExecuteStreamServer) error {
+	if f.executeStream != nil {
+		return f.executeStream(stream)
+	}
+	return f.UnimplementedPhpServiceServer.ExecuteStream(stream)
+}
+
+func (f *fakePhpService) Tail(req *pb.TailRequest, stream pb.PhpService_TailServer) error {
+	if f.tail != nil {
+		return f.tail(req, stream)
+	}
+	return f.UnimplementedPhpServiceServer.Tail(req, stream)
+}
+
+func (f *fakePhpService) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	atomic.AddInt32(&f.callCount, 1)
+	if atomic.AddInt32(&f.failures, -1) >= 0 {
+		return nil, status.Error(f.failCode, "simulated failure")
+	}
+	if f.execute != nil {
+		return f.execute(req)
+	}
+	return &pb.ExecuteResponse{StatusCode: 200, Body: []byte("ok")}, nil
+}
+
+// dialFake starts svc on an in-process bufconn listener and returns a
+// Client connected to it.
+func dialFake(t *testing.T, svc pb.PhpServiceServer, opts ...ClientOption) *Client {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterPhpServiceServer(srv, svc)
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.Logf("bufconn server exited: %v", err)
+		}
+	}()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+
+	allOpts := append([]ClientOption{
+		WithDialOption(grpc.WithContextDialer(dialer)),
+		WithDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	}, opts...)
+
+	client, err := NewClient(context.Background(), "bufconn", allOpts...)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestClient_Execute(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *fakePhpService
+		req     *pb.ExecuteRequest
+		want    *pb.ExecuteResponse
+		wantErr bool
+	}{
+		{
+			name: "success",
+			svc:  &fakePhpService{},
+			req:  &pb.ExecuteRequest{ScriptPath: "index.php", Method: "GET"},
+			want: &pb.ExecuteResponse{StatusCode: 200, Body: []byte("ok")},
+		},
+		{
+			name: "echoes request options",
+			svc: &fakePhpService{execute: func(req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+				return &pb.ExecuteResponse{StatusCode: 200, Body: []byte(req.GetOptions().GetEnv()["FOO"])}, nil
+			}},
+			req: &pb.ExecuteRequest{
+				ScriptPath: "env.php",
+				Options:    NewRequestOptions().WithEnv("FOO", "bar").Build(),
+			},
+			want: &pb.ExecuteResponse{StatusCode: 200, Body: []byte("bar")},
+		},
+		{
+			name:    "non-retryable error surfaces immediately",
+			svc:     &fakePhpService{failures: 1, failCode: codes.InvalidArgument},
+			req:     &pb.ExecuteRequest{ScriptPath: "broken.php"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := dialFake(t, tt.svc)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			got, err := client.Execute(ctx, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GetStatusCode() != tt.want.GetStatusCode() || string(got.GetBody()) != string(tt.want.GetBody()) {
+				t.Errorf("Execute() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_ExecuteRetriesOnUnavailable(t *testing.T) {
+	svc := &fakePhpService{failures: 2, failCode: codes.Unavailable}
+	client := dialFake(t, svc, WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := client.Execute(ctx, &pb.ExecuteRequest{ScriptPath: "flaky.php"})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if resp.GetStatusCode() != 200 {
+		t.Errorf("StatusCode = %d, want 200", resp.GetStatusCode())
+	}
+	if got := atomic.LoadInt32(&svc.callCount); got != 3 {
+		t.Errorf("callCount = %d, want 3 (2 failures + 1 success)", got)
+	}
+}
+
+func TestClient_ExecuteGivesUpAfterMaxAttempts(t *testing.T) {
+	svc := &fakePhpService{failures: 10, failCode: codes.Unavailable}
+	client := dialFake(t, svc, WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    10 * time.Millisecond,
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.Execute(ctx, &pb.ExecuteRequest{ScriptPath: "flaky.php"}); err == nil {
+		t.Fatal("Execute() expected error after exhausting retries")
+	}
+	if got := atomic.LoadInt32(&svc.callCount); got != 2 {
+		t.Errorf("callCount = %d, want 2", got)
+	}
+}
@@ -0,0 +1,31 @@
+// Package tokiophp is the official Go SDK for the tokio_php gRPC server.
+//
+// It wraps the generated PhpService stubs in
+// github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1 with a Client
+// that handles connection pooling, keepalive, and retries so callers don't
+// have to reimplement that plumbing for every service that talks to
+// tokio_php.
+//
+//	client, err := tokiophp.NewClient(ctx, "tokio-php:50051")
+//	if err != nil {
+//		return err
+//	}
+//	defer client.Close()
+//
+//	resp, err := client.Execute(ctx, &pb.ExecuteRequest{
+//		ScriptPath: "index.php",
+//		Method:     "GET",
+//	})
+//
+// By default NewClient dials without transport security. Production
+// deployments should pair WithTLS (or WithTLS plus a client certificate
+// for mutual TLS) with matching --tls-ca/--tls-cert/--tls-key flags on
+// the tokio_php server, and/or WithTokenAuth to send a bearer token read
+// from TOKIO_PHP_TOKEN or a custom TokenSource on every RPC.
+//
+// HealthCheck and WatchHealth speak the standard gRPC Health Checking
+// Protocol against whatever the server registers as grpc.health.v1.Health
+// — that registration, and any grpc_reflection_v1 registration for
+// grpcurl, belongs in the tokio_php server itself, which lives outside
+// this SDK's module.
+package tokiophp
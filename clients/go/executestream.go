@@ -0,0 +1,123 @@
+package tokiophp
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// streamChunkSize is how much of the request body ExecuteStream reads at a
+// time before sending it as a BodyChunk message.
+const streamChunkSize = 32 * 1024
+
+// ExecuteStreamResult is the in-flight result of an ExecuteStream call.
+// Body can be read as response chunks arrive; Wait blocks for the call to
+// finish and returns the trailing metadata.
+type ExecuteStreamResult struct {
+	// Body streams the response body as it's received from the server.
+	// Reading from it blocks until the server sends more data, the
+	// stream ends, or ctx is cancelled.
+	Body io.Reader
+
+	done    chan struct{}
+	trailer *pb.Trailer
+	err     error
+}
+
+// Wait blocks until the stream completes and returns the server's
+// trailing status/headers, or the error that ended the stream.
+func (r *ExecuteStreamResult) Wait() (*pb.Trailer, error) {
+	<-r.done
+	return r.trailer, r.err
+}
+
+// ExecuteStream runs a PHP script with a streamed request body and
+// returns a streamed response. meta must at least set ScriptPath; body
+// may be nil for requests with no body. Both directions honor gRPC's
+// flow-control backpressure: Send blocks while the peer is slow to read,
+// so a large body or response never needs to be buffered in full.
+//
+// Cancelling ctx aborts the stream in both directions.
+func (c *Client) ExecuteStream(ctx context.Context, meta *pb.ExecuteRequest, body io.Reader) (*ExecuteStreamResult, error) {
+	stub := pb.NewPhpServiceClient(c.pool.pick())
+	stream, err := stub.ExecuteStream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tokiophp: open execute stream: %w", err)
+	}
+
+	if err := stream.Send(&pb.ExecuteStreamRequest{
+		Payload: &pb.ExecuteStreamRequest_Metadata{Metadata: meta},
+	}); err != nil {
+		return nil, fmt.Errorf("tokiophp: send execute stream metadata: %w", err)
+	}
+
+	go pumpRequestBody(stream, body)
+
+	pr, pw := io.Pipe()
+	result := &ExecuteStreamResult{Body: pr, done: make(chan struct{})}
+	go result.recvLoop(stream, pw)
+
+	return result, nil
+}
+
+// pumpRequestBody sends body as a sequence of BodyChunk messages, then
+// closes the send side of the stream regardless of how it got there, so
+// the server always sees a clean end-of-request even if body returned an
+// error partway through.
+func pumpRequestBody(stream pb.PhpService_ExecuteStreamClient, body io.Reader) {
+	defer func() { _ = stream.CloseSend() }()
+
+	if body == nil {
+		return
+	}
+
+	buf := make([]byte, streamChunkSize)
+	for {
+		n, readErr := body.Read(buf)
+		if n > 0 {
+			chunk := make([]byte, n)
+			copy(chunk, buf[:n])
+			if sendErr := stream.Send(&pb.ExecuteStreamRequest{
+				Payload: &pb.ExecuteStreamRequest_BodyChunk{BodyChunk: chunk},
+			}); sendErr != nil {
+				return
+			}
+		}
+		if readErr != nil {
+			return
+		}
+	}
+}
+
+// recvLoop reads response messages until the server closes the stream,
+// writing body chunks to pw and capturing the final trailer.
+func (r *ExecuteStreamResult) recvLoop(stream pb.PhpService_ExecuteStreamClient, pw *io.PipeWriter) {
+	defer close(r.done)
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			_ = pw.Close()
+			return
+		}
+		if err != nil {
+			r.err = err
+			_ = pw.CloseWithError(err)
+			return
+		}
+
+		switch payload := msg.GetPayload().(type) {
+		case *pb.ExecuteStreamResponse_BodyChunk:
+			if _, err := pw.Write(payload.BodyChunk); err != nil {
+				// The reader gave up; keep draining Recv so the
+				// underlying stream can be cleaned up, but stop
+				// copying data.
+				continue
+			}
+		case *pb.ExecuteStreamResponse_Trailer:
+			r.trailer = payload.Trailer
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package tokiophp
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+func TestClient_ExecuteStream_EchoesBody(t *testing.T) {
+	svc := &fakePhpService{
+		executeStream: func(stream pb.PhpService_ExecuteStreamServer) error {
+			first, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			if first.GetMetadata().GetScriptPath() != "upload.php" {
+				return status.Error(codes.InvalidArgument, "expected metadata first")
+			}
+
+			var body bytes.Buffer
+			for {
+				msg, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				body.Write(msg.GetBodyChunk())
+			}
+
+			// Echo the body back in two chunks to exercise multi-message
+			// responses, then a trailing status.
+			mid := body.Len() / 2
+			if err := stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_BodyChunk{BodyChunk: body.Bytes()[:mid]},
+			}); err != nil {
+				return err
+			}
+			if err := stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_BodyChunk{BodyChunk: body.Bytes()[mid:]},
+			}); err != nil {
+				return err
+			}
+			return stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_Trailer{Trailer: &pb.Trailer{StatusCode: 200}},
+			})
+		},
+	}
+	client := dialFake(t, svc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	want := strings.Repeat("abcdefgh", 10_000) // large enough to span several chunks
+	result, err := client.ExecuteStream(ctx, &pb.ExecuteRequest{ScriptPath: "upload.php"}, strings.NewReader(want))
+	if err != nil {
+		t.Fatalf("ExecuteStream: %v", err)
+	}
+
+	got, err := io.ReadAll(result.Body)
+	if err != nil {
+		t.Fatalf("reading Body: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("body mismatch: got %d bytes, want %d bytes", len(got), len(want))
+	}
+
+	trailer, err := result.Wait()
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if trailer.GetStatusCode() != 200 {
+		t.Errorf("trailer.StatusCode = %d, want 200", trailer.GetStatusCode())
+	}
+}
+
+func TestClient_ExecuteStream_NilBodyClosesImmediately(t *testing.T) {
+	svc := &fakePhpService{
+		executeStream: func(stream pb.PhpService_ExecuteStreamServer) error {
+			if _, err := stream.Recv(); err != nil {
+				return err
+			}
+			if _, err := stream.Recv(); err != io.EOF {
+				return status.Error(codes.FailedPrecondition, "expected client to close send")
+			}
+			return stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_Trailer{Trailer: &pb.Trailer{StatusCode: 204}},
+			})
+		},
+	}
+	client := dialFake(t, svc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := client.ExecuteStream(ctx, &pb.ExecuteRequest{ScriptPath: "noop.php"}, nil)
+	if err != nil {
+		t.Fatalf("ExecuteStream: %v", err)
+	}
+	if _, err := io.ReadAll(result.Body); err != nil {
+		t.Fatalf("reading Body: %v", err)
+	}
+	trailer, err := result.Wait()
+	if err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+	if trailer.GetStatusCode() != 204 {
+		t.Errorf("trailer.StatusCode = %d, want 204", trailer.GetStatusCode())
+	}
+}
+
+func TestClient_ExecuteStream_CancelUnblocksBody(t *testing.T) {
+	blockUntilCancelled := make(chan struct{})
+	svc := &fakePhpService{
+		executeStream: func(stream pb.PhpService_ExecuteStreamServer) error {
+			<-blockUntilCancelled
+			return status.Error(codes.Canceled, "client cancelled")
+		},
+	}
+	client := dialFake(t, svc)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result, err := client.ExecuteStream(ctx, &pb.ExecuteRequest{ScriptPath: "slow.php"}, nil)
+	if err != nil {
+		t.Fatalf("ExecuteStream: %v", err)
+	}
+
+	cancel()
+	close(blockUntilCancelled)
+
+	if _, err := io.ReadAll(result.Body); err == nil {
+		t.Fatal("expected Body read to fail after cancellation")
+	}
+	if _, err := result.Wait(); err == nil {
+		t.Fatal("expected Wait to report the cancellation error")
+	}
+}
+
+// TestClient_ExecuteStream_Backpressure verifies that a slow reader of
+// ExecuteStreamResult.Body actually stalls the server's Send calls
+// instead of the client buffering the whole response in memory first.
+// recvLoop only calls stream.Recv again after its io.Pipe write to the
+// consumer returns, so a slow consumer stops acking gRPC's flow-control
+// window and the server blocks on Send once enough unread data has
+// piled up.
+func TestClient_ExecuteStream_Backpressure(t *testing.T) {
+	const chunkSize = 64 * 1024
+	const numChunks = 20
+	const readDelay = 5 * time.Millisecond
+
+	serverSendElapsed := make(chan time.Duration, 1)
+	svc := &fakePhpService{
+		executeStream: func(stream pb.PhpService_ExecuteStreamServer) error {
+			if _, err := stream.Recv(); err != nil { // metadata
+				return err
+			}
+			if _, err := stream.Recv(); err != io.EOF {
+				return status.Error(codes.FailedPrecondition, "expected client to close send")
+			}
+
+			chunk := bytes.Repeat([]byte{'x'}, chunkSize)
+			start := time.Now()
+			for i := 0; i < numChunks; i++ {
+				if err := stream.Send(&pb.ExecuteStreamResponse{
+					Payload: &pb.ExecuteStreamResponse_BodyChunk{BodyChunk: chunk},
+				}); err != nil {
+					return err
+				}
+			}
+			serverSendElapsed <- time.Since(start)
+
+			return stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_Trailer{Trailer: &pb.Trailer{StatusCode: 200}},
+			})
+		},
+	}
+	client := dialFake(t, svc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := client.ExecuteStream(ctx, &pb.ExecuteRequest{ScriptPath: "firehose.php"}, nil)
+	if err != nil {
+		t.Fatalf("ExecuteStream: %v", err)
+	}
+
+	var total int
+	buf := make([]byte, 8*1024)
+	for {
+		time.Sleep(readDelay)
+		n, readErr := result.Body.Read(buf)
+		total += n
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			t.Fatalf("reading Body: %v", readErr)
+		}
+	}
+
+	if want := chunkSize * numChunks; total != want {
+		t.Fatalf("read %d bytes, want %d", total, want)
+	}
+	if _, err := result.Wait(); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	// If Send didn't block on the slow reader, the server would finish
+	// writing all numChunks well under a millisecond; require it to have
+	// taken long enough that at least some Send calls must have waited
+	// on the client draining the pipe.
+	if elapsed := <-serverSendElapsed; elapsed < 20*readDelay {
+		t.Errorf("server finished Send-ing in %v without waiting on the slow reader; want backpressure to delay it", elapsed)
+	}
+}
+
+func TestClient_Tail(t *testing.T) {
+	svc := &fakePhpService{
+		tail: func(req *pb.TailRequest, stream pb.PhpService_TailServer) error {
+			if req.GetScriptPath() != "worker.php" {
+				return status.Error(codes.InvalidArgument, "unexpected script path")
+			}
+			lines := []*pb.TailLine{
+				{Stream: pb.TailLine_STDOUT, Data: []byte("starting\n")},
+				{Stream: pb.TailLine_STDERR, Data: []byte("warning: low memory\n")},
+			}
+			for _, line := range lines {
+				if err := stream.Send(line); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	client := dialFake(t, svc)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	tail, err := client.Tail(ctx, "worker.php")
+	if err != nil {
+		t.Fatalf("Tail: %v", err)
+	}
+
+	var got []string
+	for {
+		line, err := tail.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv: %v", err)
+		}
+		got = append(got, string(line.GetData()))
+	}
+
+	want := []string{"starting\n", "warning: low memory\n"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d lines, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
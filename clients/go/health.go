@@ -0,0 +1,38 @@
+package tokiophp
+
+import (
+	"context"
+	"fmt"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthCheck calls the standard gRPC Health Checking Protocol
+// (grpc.health.v1.Health/Check). service selects which service's health
+// to report; the empty string means "the server as a whole".
+//
+// This assumes the tokio_php server registers grpc.health.v1.Health
+// (reporting SERVING once its PHP worker pool is warm and NOT_SERVING
+// while draining) — that registration lives in the server itself, which
+// isn't part of this SDK's tree.
+func (c *Client) HealthCheck(ctx context.Context, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	resp, err := healthpb.NewHealthClient(c.pool.pick()).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return healthpb.HealthCheckResponse_UNKNOWN, fmt.Errorf("tokiophp: health check %q: %w", service, err)
+	}
+	return resp.GetStatus(), nil
+}
+
+// WatchHealth streams health status changes for service
+// (grpc.health.v1.Health/Watch), so a load balancer or supervisor can
+// react as soon as the server's status flips instead of polling
+// HealthCheck. Callers should keep calling Recv on the returned stream
+// until it errors, which happens when ctx is cancelled or the server
+// closes the stream.
+func (c *Client) WatchHealth(ctx context.Context, service string) (healthpb.Health_WatchClient, error) {
+	stream, err := healthpb.NewHealthClient(c.pool.pick()).Watch(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return nil, fmt.Errorf("tokiophp: watch health %q: %w", service, err)
+	}
+	return stream, nil
+}
@@ -0,0 +1,102 @@
+package tokiophp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialFakeWithHealth starts a bufconn server exposing only the standard
+// health service, and returns a Client plus the health server so tests
+// can flip serving status.
+func dialFakeWithHealth(t *testing.T) (*Client, *health.Server) {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	healthSrv := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.Logf("bufconn server exited: %v", err)
+		}
+	}()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+
+	client, err := NewClient(context.Background(), "bufconn",
+		WithDialOption(grpc.WithContextDialer(dialer)),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+
+	return client, healthSrv
+}
+
+func TestClient_HealthCheck(t *testing.T) {
+	client, healthSrv := dialFakeWithHealth(t)
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	status, err := client.HealthCheck(ctx, "")
+	if err != nil {
+		t.Fatalf("HealthCheck: %v", err)
+	}
+	if status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("status = %v, want SERVING", status)
+	}
+
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	status, err = client.HealthCheck(ctx, "")
+	if err != nil {
+		t.Fatalf("HealthCheck: %v", err)
+	}
+	if status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("status = %v, want NOT_SERVING", status)
+	}
+}
+
+func TestClient_WatchHealth(t *testing.T) {
+	client, healthSrv := dialFakeWithHealth(t)
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchHealth(ctx, "")
+	if err != nil {
+		t.Fatalf("WatchHealth: %v", err)
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if first.GetStatus() != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("first status = %v, want NOT_SERVING", first.GetStatus())
+	}
+
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	second, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv: %v", err)
+	}
+	if second.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("second status = %v, want SERVING", second.GetStatus())
+	}
+}
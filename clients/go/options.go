@@ -0,0 +1,48 @@
+package tokiophp
+
+import (
+	"time"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// RequestOptionsBuilder builds a *pb.RequestOptions one field at a time so
+// callers don't have to depend on protobuf-generated field names directly.
+type RequestOptionsBuilder struct {
+	opts pb.RequestOptions
+}
+
+// NewRequestOptions starts a new RequestOptionsBuilder.
+func NewRequestOptions() *RequestOptionsBuilder {
+	return &RequestOptionsBuilder{}
+}
+
+// WithTimeout sets the server-side execution timeout for the request.
+func (b *RequestOptionsBuilder) WithTimeout(d time.Duration) *RequestOptionsBuilder {
+	b.opts.TimeoutMs = uint32(d.Milliseconds())
+	return b
+}
+
+// WithEnv adds a PHP environment variable, overwriting any previous value
+// for the same key.
+func (b *RequestOptionsBuilder) WithEnv(key, value string) *RequestOptionsBuilder {
+	if b.opts.Env == nil {
+		b.opts.Env = make(map[string]string)
+	}
+	b.opts.Env[key] = value
+	return b
+}
+
+// WithHeader adds an HTTP header to forward to the PHP script.
+func (b *RequestOptionsBuilder) WithHeader(key, value string) *RequestOptionsBuilder {
+	if b.opts.Headers == nil {
+		b.opts.Headers = make(map[string]string)
+	}
+	b.opts.Headers[key] = value
+	return b
+}
+
+// Build returns the assembled RequestOptions.
+func (b *RequestOptionsBuilder) Build() *pb.RequestOptions {
+	return &b.opts
+}
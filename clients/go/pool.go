@@ -0,0 +1,73 @@
+package tokiophp
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// defaultKeepalive matches what tokio_php's server expects from long-lived
+// clients: pings often enough to notice a dead connection before the load
+// balancer does, but not so often it trips the server's min-time policy.
+var defaultKeepalive = keepalive.ClientParameters{
+	Time:                20 * time.Second,
+	Timeout:             5 * time.Second,
+	PermitWithoutStream: true,
+}
+
+// connPool is a small round-robin pool of grpc.ClientConn to the same
+// target. A single *grpc.ClientConn already multiplexes RPCs over one
+// HTTP/2 connection, but pooling several of them spreads load across more
+// TCP connections (and, transitively, more server-side HTTP/2 flow-control
+// windows) under high concurrency.
+type connPool struct {
+	conns []*grpc.ClientConn
+	next  uint64
+}
+
+func newConnPool(target string, size int, dialOpts ...grpc.DialOption) (*connPool, error) {
+	if size < 1 {
+		size = 1
+	}
+
+	// grpc.NewClient resolves its target with the DNS resolver by default,
+	// unlike the old grpc.Dial. This package treats target as an opaque
+	// string handed to whatever dialer the caller configured (bufconn in
+	// tests, grpc's default TCP dialer when none is set), so resolve it with the
+	// passthrough scheme instead of letting the DNS resolver reject it for
+	// producing zero addresses.
+	dialTarget := "passthrough:///" + target
+
+	conns := make([]*grpc.ClientConn, 0, size)
+	for i := 0; i < size; i++ {
+		conn, err := grpc.NewClient(dialTarget, dialOpts...)
+		if err != nil {
+			for _, c := range conns {
+				_ = c.Close()
+			}
+			return nil, fmt.Errorf("tokiophp: dial %q (conn %d/%d): %w", target, i+1, size, err)
+		}
+		conns = append(conns, conn)
+	}
+
+	return &connPool{conns: conns}, nil
+}
+
+// pick returns the next connection in round-robin order.
+func (p *connPool) pick() *grpc.ClientConn {
+	i := atomic.AddUint64(&p.next, 1)
+	return p.conns[i%uint64(len(p.conns))]
+}
+
+func (p *connPool) Close() error {
+	var firstErr error
+	for _, c := range p.conns {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
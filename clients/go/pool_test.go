@@ -0,0 +1,126 @@
+package tokiophp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// dialFakeBackend starts a fakePhpService on its own in-process bufconn
+// listener, tagging every response with id so tests can tell which
+// backend served a given RPC.
+func dialFakeBackend(t *testing.T, id string) *bufconn.Listener {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterPhpServiceServer(srv, &fakePhpService{
+		execute: func(*pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+			return &pb.ExecuteResponse{StatusCode: 200, Body: []byte(id)}, nil
+		},
+	})
+	go func() {
+		if err := srv.Serve(lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.Logf("bufconn server exited: %v", err)
+		}
+	}()
+	t.Cleanup(srv.Stop)
+	return lis
+}
+
+// TestConnPool_RoundRobinsAcrossConns verifies that a pool of size n>1
+// spreads RPCs evenly across its underlying *grpc.ClientConn, exercising
+// pick()'s atomic.AddUint64/modulo arithmetic against real round-trips
+// instead of just reading the counter back.
+func TestConnPool_RoundRobinsAcrossConns(t *testing.T) {
+	const size = 3
+	backends := make([]*bufconn.Listener, size)
+	for i := range backends {
+		backends[i] = dialFakeBackend(t, string(rune('a'+i)))
+	}
+
+	var dialCount int
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		lis := backends[dialCount%size]
+		dialCount++
+		return lis.DialContext(ctx)
+	}
+
+	pool, err := newConnPool("bufconn", size,
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("newConnPool: %v", err)
+	}
+	t.Cleanup(func() { _ = pool.Close() })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	got := make(map[string]int)
+	const rounds = size * 4
+	for i := 0; i < rounds; i++ {
+		stub := pb.NewPhpServiceClient(pool.pick())
+		resp, err := stub.Execute(ctx, &pb.ExecuteRequest{ScriptPath: "index.php"})
+		if err != nil {
+			t.Fatalf("Execute() call %d: %v", i, err)
+		}
+		got[string(resp.GetBody())]++
+	}
+
+	if len(got) != size {
+		t.Fatalf("RPCs landed on %d distinct backends, want %d: %v", len(got), size, got)
+	}
+	for id, count := range got {
+		if want := rounds / size; count != want {
+			t.Errorf("backend %q served %d calls, want %d", id, count, want)
+		}
+	}
+}
+
+// TestConnPool_CloseClosesEveryConn verifies Close() tears down all
+// pooled connections, not just the first, so a pool of size>1 doesn't
+// leak connections on shutdown.
+func TestConnPool_CloseClosesEveryConn(t *testing.T) {
+	const size = 3
+	backends := make([]*bufconn.Listener, size)
+	for i := range backends {
+		backends[i] = dialFakeBackend(t, string(rune('a'+i)))
+	}
+
+	var dialCount int
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		lis := backends[dialCount%size]
+		dialCount++
+		return lis.DialContext(ctx)
+	}
+
+	pool, err := newConnPool("bufconn", size,
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("newConnPool: %v", err)
+	}
+	if len(pool.conns) != size {
+		t.Fatalf("len(pool.conns) = %d, want %d", len(pool.conns), size)
+	}
+
+	if err := pool.Close(); err != nil {
+		t.Fatalf("Close(): %v", err)
+	}
+	for i, c := range pool.conns {
+		if state := c.GetState(); state.String() != "SHUTDOWN" {
+			t.Errorf("conn %d state = %v, want SHUTDOWN", i, state)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package tokiophp
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy controls the exponential backoff retries Client applies to
+// RPCs that fail with a retryable status code.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of tries, including the first.
+	// A value <= 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the upper bound of the jittered delay before the first
+	// retry; the bound doubles for each further retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff so a flaky dependency can't make callers
+	// wait arbitrarily long between attempts.
+	MaxDelay time.Duration
+}
+
+// defaultRetryPolicy retries UNAVAILABLE and DEADLINE_EXCEEDED, which are
+// the two codes tokio_php returns for transient conditions (worker pool
+// draining, or a slow-starting PHP process racing the caller's deadline).
+func defaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+	}
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff returns the delay to wait after failed attempt n (1-indexed)
+// before the next one, as full jitter exponential backoff bounded by
+// policy.MaxDelay.
+func backoff(policy RetryPolicy, attempt int) time.Duration {
+	d := policy.BaseDelay << (attempt - 1)
+	if d <= 0 || d > policy.MaxDelay {
+		d = policy.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// withRetry runs fn, retrying on retryable errors according to policy. It
+// stops early if ctx is done.
+func withRetry(ctx context.Context, policy RetryPolicy, fn func() error) error {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= policy.MaxAttempts; attempt++ {
+		err = fn()
+		if err == nil || !isRetryable(err) || attempt == policy.MaxAttempts {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff(policy, attempt)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return err
+}
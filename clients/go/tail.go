@@ -0,0 +1,31 @@
+package tokiophp
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// TailStream streams stdout/stderr lines from a running PHP script.
+type TailStream struct {
+	stream pb.PhpService_TailClient
+}
+
+// Recv returns the next line. It returns io.EOF once the script has
+// exited and the server has closed the stream cleanly, or another error
+// if the stream failed or ctx was cancelled.
+func (t *TailStream) Recv() (*pb.TailLine, error) {
+	return t.stream.Recv()
+}
+
+// Tail follows stdout/stderr for scriptPath until the script exits or ctx
+// is cancelled.
+func (c *Client) Tail(ctx context.Context, scriptPath string) (*TailStream, error) {
+	stub := pb.NewPhpServiceClient(c.pool.pick())
+	stream, err := stub.Tail(ctx, &pb.TailRequest{ScriptPath: scriptPath})
+	if err != nil {
+		return nil, fmt.Errorf("tokiophp: tail %q: %w", scriptPath, err)
+	}
+	return &TailStream{stream: stream}, nil
+}
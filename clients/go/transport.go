@@ -0,0 +1,147 @@
+package tokiophp
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TokenEnvVar is the environment variable EnvTokenSource reads from.
+const TokenEnvVar = "TOKIO_PHP_TOKEN"
+
+// TLSConfig configures the transport credentials NewClient dials with.
+// The matching tokio_php server flags are --tls-ca, --tls-cert and
+// --tls-key (the last two also enabling client-certificate verification
+// when set), which must be configured consistently with whichever of
+// these fields are set here.
+type TLSConfig struct {
+	// CAFile is a PEM-encoded CA bundle used to verify the server's
+	// certificate. If empty, the host's system root CAs are used.
+	CAFile string
+	// ServerName overrides the SNI/certificate verification name, for
+	// targets addressed by IP or behind a load balancer.
+	ServerName string
+	// CertFile and KeyFile identify the client certificate/key pair
+	// presented for mutual TLS. Leave both empty to skip client auth.
+	CertFile, KeyFile string
+	// Certificate is an in-memory alternative to CertFile/KeyFile, for
+	// callers that already have a loaded certificate (e.g. from Vault).
+	// Takes precedence over CertFile/KeyFile if set.
+	Certificate *tls.Certificate
+}
+
+// WithTLS configures the Client to dial over TLS, and over mutual TLS if
+// cfg sets a client certificate, instead of the package's insecure
+// default.
+func WithTLS(cfg TLSConfig) ClientOption {
+	return func(c *clientConfig) {
+		creds, err := buildTransportCredentials(cfg)
+		if err != nil {
+			c.transportErr = err
+			return
+		}
+		c.dialOpts = append(c.dialOpts, grpc.WithTransportCredentials(creds))
+	}
+}
+
+func buildTransportCredentials(cfg TLSConfig) (credentials.TransportCredentials, error) {
+	tlsCfg := &tls.Config{ServerName: cfg.ServerName}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("tokiophp: read CA bundle %q: %w", cfg.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("tokiophp: no certificates found in CA bundle %q", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	switch {
+	case cfg.Certificate != nil:
+		tlsCfg.Certificates = []tls.Certificate{*cfg.Certificate}
+	case cfg.CertFile != "" || cfg.KeyFile != "":
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("tokiophp: load client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// TokenSource returns the bearer token to attach to an RPC. Implementations
+// may read from a static source, a file that's rotated externally, or an
+// OIDC/Vault client that mints short-lived tokens on demand.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// EnvTokenSource reads a bearer token from the TOKIO_PHP_TOKEN
+// environment variable. It's the default TokenSource for WithTokenAuth
+// and WithInsecureTokenAuth when source is nil.
+type EnvTokenSource struct{}
+
+// Token implements TokenSource.
+func (EnvTokenSource) Token(context.Context) (string, error) {
+	token := os.Getenv(TokenEnvVar)
+	if token == "" {
+		return "", fmt.Errorf("tokiophp: %s is not set", TokenEnvVar)
+	}
+	return token, nil
+}
+
+// perRPCToken implements credentials.PerRPCCredentials by asking a
+// TokenSource for a bearer token before every RPC.
+type perRPCToken struct {
+	source     TokenSource
+	requireTLS bool
+}
+
+func (t *perRPCToken) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := t.source.Token(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("tokiophp: get token: %w", err)
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+func (t *perRPCToken) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
+
+// WithTokenAuth attaches a bearer token, obtained from source, to every
+// RPC's "authorization" header. Pass nil to use EnvTokenSource{}.
+//
+// Per-RPC credentials require a secure transport by default, so pair this
+// with WithTLS in production; use WithInsecureTokenAuth instead to run
+// without TLS (e.g. against a bufconn server in tests).
+func WithTokenAuth(source TokenSource) ClientOption {
+	return withTokenAuth(source, true)
+}
+
+// WithInsecureTokenAuth is WithTokenAuth without the transport-security
+// requirement.
+func WithInsecureTokenAuth(source TokenSource) ClientOption {
+	return withTokenAuth(source, false)
+}
+
+func withTokenAuth(source TokenSource, requireTLS bool) ClientOption {
+	if source == nil {
+		source = EnvTokenSource{}
+	}
+	return func(c *clientConfig) {
+		c.dialOpts = append(c.dialOpts, grpc.WithPerRPCCredentials(&perRPCToken{
+			source:     source,
+			requireTLS: requireTLS,
+		}))
+	}
+}
@@ -0,0 +1,172 @@
+package tokiophp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// writeTestCert generates a throwaway self-signed certificate and writes
+// its PEM-encoded cert and key to dir, returning their paths.
+func writeTestCert(t *testing.T, dir, name string) (certPath, keyPath string) {
+	t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &priv.PublicKey, priv)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+
+	certPath = filepath.Join(dir, name+".crt")
+	keyPath = filepath.Join(dir, name+".key")
+
+	certOut, err := os.Create(certPath)
+	if err != nil {
+		t.Fatalf("create cert file: %v", err)
+	}
+	defer certOut.Close()
+	if err := pem.Encode(certOut, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		t.Fatalf("encode cert: %v", err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	keyOut, err := os.Create(keyPath)
+	if err != nil {
+		t.Fatalf("create key file: %v", err)
+	}
+	defer keyOut.Close()
+	if err := pem.Encode(keyOut, &pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}); err != nil {
+		t.Fatalf("encode key: %v", err)
+	}
+
+	return certPath, keyPath
+}
+
+func TestBuildTransportCredentials(t *testing.T) {
+	dir := t.TempDir()
+	certPath, keyPath := writeTestCert(t, dir, "client")
+
+	badCAFile := filepath.Join(dir, "not-pem.crt")
+	if err := os.WriteFile(badCAFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatalf("write bad CA file: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     TLSConfig
+		wantErr bool
+	}{
+		{name: "server TLS only", cfg: TLSConfig{CAFile: certPath}},
+		{name: "mutual TLS with files", cfg: TLSConfig{CAFile: certPath, CertFile: certPath, KeyFile: keyPath}},
+		{name: "SNI override", cfg: TLSConfig{CAFile: certPath, ServerName: "tokio-php.internal"}},
+		{name: "missing CA file", cfg: TLSConfig{CAFile: filepath.Join(dir, "missing.crt")}, wantErr: true},
+		{name: "CA file has no certificates", cfg: TLSConfig{CAFile: badCAFile}, wantErr: true},
+		{name: "missing client key", cfg: TLSConfig{CertFile: certPath, KeyFile: filepath.Join(dir, "missing.key")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creds, err := buildTransportCredentials(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildTransportCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if creds == nil {
+				t.Fatal("expected non-nil credentials")
+			}
+		})
+	}
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) { return string(s), nil }
+
+func TestClient_WithInsecureTokenAuth_AttachesBearerToken(t *testing.T) {
+	var gotAuth string
+
+	client := dialFake(t, &interceptingService{
+		onExecute: func(ctx context.Context) {
+			if md, ok := metadata.FromIncomingContext(ctx); ok {
+				if vals := md.Get("authorization"); len(vals) > 0 {
+					gotAuth = vals[0]
+				}
+			}
+		},
+	}, WithInsecureTokenAuth(staticTokenSource("s3cr3t")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.Execute(ctx, &pb.ExecuteRequest{ScriptPath: "index.php"}); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if want := "Bearer s3cr3t"; gotAuth != want {
+		t.Errorf("authorization header = %q, want %q", gotAuth, want)
+	}
+}
+
+// interceptingService wraps fakePhpService to observe the incoming
+// context (and thus request metadata) of an Execute call.
+type interceptingService struct {
+	fakePhpService
+	onExecute func(ctx context.Context)
+}
+
+func (s *interceptingService) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	if s.onExecute != nil {
+		s.onExecute(ctx)
+	}
+	return &pb.ExecuteResponse{StatusCode: 200}, nil
+}
+
+func TestPerRPCToken_PropagatesTokenSourceErrors(t *testing.T) {
+	erroringSource := tokenSourceFunc(func(context.Context) (string, error) {
+		return "", status.Error(codes.Unauthenticated, "token expired")
+	})
+	client := dialFake(t, &fakePhpService{}, WithInsecureTokenAuth(erroringSource))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if _, err := client.Execute(ctx, &pb.ExecuteRequest{ScriptPath: "index.php"}); err == nil {
+		t.Fatal("expected Execute to fail when the token source errors")
+	}
+}
+
+type tokenSourceFunc func(context.Context) (string, error)
+
+func (f tokenSourceFunc) Token(ctx context.Context) (string, error) { return f(ctx) }
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+
+	tokiophp "github.com/petstack/tokio_php/clients/go"
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// makeExecuteEndpoint adapts Client.Execute to a go-kit endpoint so it can
+// share error handling and (eventually) middleware with any other
+// transport the gateway grows, not just HTTP.
+func makeExecuteEndpoint(client *tokiophp.Client) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(executeHTTPRequest)
+		if !ok {
+			return nil, fmt.Errorf("gateway: unexpected request type %T", request)
+		}
+
+		pbReq := &pb.ExecuteRequest{
+			ScriptPath:  req.ScriptPath,
+			Method:      req.Method,
+			QueryParams: req.QueryParams,
+			Body:        req.Body,
+		}
+		if req.Options != nil {
+			pbReq.Options = &pb.RequestOptions{
+				TimeoutMs: req.Options.TimeoutMs,
+				Env:       req.Options.Env,
+				Headers:   req.Options.Headers,
+			}
+		}
+
+		resp, err := client.Execute(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+
+		return executeHTTPResponse{
+			StatusCode: resp.GetStatusCode(),
+			Headers:    resp.GetHeaders(),
+			Body:       resp.GetBody(),
+		}, nil
+	}
+}
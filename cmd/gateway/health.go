@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	tokiophp "github.com/petstack/tokio_php/clients/go"
+)
+
+// handleHealth backs GET /health. It proxies grpc.health.v1.Health/Check
+// against the tokio_php server as a whole, translating the serving
+// status (or a failed check) to the closest HTTP status, so callers
+// that can't speak gRPC still get an accurate signal.
+func handleHealth(client *tokiophp.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status, err := client.HealthCheck(r.Context(), "")
+		w.Header().Set("Content-Type", "application/json")
+		if err != nil {
+			w.WriteHeader(httpStatusFromGRPC(err))
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+
+		httpStatus := http.StatusOK
+		if status != healthpb.HealthCheckResponse_SERVING {
+			httpStatus = http.StatusServiceUnavailable
+		}
+		w.WriteHeader(httpStatus)
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": status.String()})
+	}
+}
@@ -0,0 +1,53 @@
+// Command gateway fronts a tokio_php gRPC server with a REST+JSON HTTP
+// interface, so callers that can't host a gRPC client (curl, browsers,
+// legacy PHP callers) can still execute scripts through tokio_php.
+//
+// Usage:
+//
+//	go run . -grpc-addr=localhost:50051 -http-addr=:8080
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net/http"
+
+	kithttp "github.com/go-kit/kit/transport/http"
+
+	tokiophp "github.com/petstack/tokio_php/clients/go"
+)
+
+func main() {
+	grpcAddr := flag.String("grpc-addr", "localhost:50051", "tokio_php gRPC server address")
+	httpAddr := flag.String("http-addr", ":8080", "address to serve the HTTP/JSON gateway on")
+	flag.Parse()
+
+	client, err := tokiophp.NewClient(context.Background(), *grpcAddr)
+	if err != nil {
+		log.Fatalf("gateway: connect to %s: %v", *grpcAddr, err)
+	}
+	defer client.Close()
+
+	log.Printf("gateway listening on %s, proxying to %s", *httpAddr, *grpcAddr)
+	if err := http.ListenAndServe(*httpAddr, newMux(client)); err != nil {
+		log.Fatalf("gateway: %v", err)
+	}
+}
+
+func newMux(client *tokiophp.Client) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	executeHandler := kithttp.NewServer(
+		makeExecuteEndpoint(client),
+		decodeExecuteRequest,
+		encodeExecuteResponse,
+		kithttp.ServerErrorEncoder(encodeError),
+	)
+
+	mux.Handle("POST /execute", executeHandler)
+	mux.HandleFunc("POST /execute/stream", handleExecuteStream(client))
+	mux.HandleFunc("GET /health", handleHealth(client))
+
+	return mux
+}
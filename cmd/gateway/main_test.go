@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+
+	tokiophp "github.com/petstack/tokio_php/clients/go"
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+// fakePhpService is a minimal in-memory PhpServiceServer for exercising
+// the gateway end to end without a real tokio_php server.
+type fakePhpService struct {
+	pb.UnimplementedPhpServiceServer
+
+	executeStream func(pb.PhpService_ExecuteStreamServer) error
+}
+
+func (fakePhpService) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	return &pb.ExecuteResponse{
+		StatusCode: 200,
+		Body:       []byte("script=" + req.GetScriptPath()),
+	}, nil
+}
+
+func (f fakePhpService) ExecuteStream(stream pb.PhpService_ExecuteStreamServer) error {
+	if f.executeStream != nil {
+		return f.executeStream(stream)
+	}
+	return f.UnimplementedPhpServiceServer.ExecuteStream(stream)
+}
+
+func dialFakeClient(t *testing.T, svc pb.PhpServiceServer) *tokiophp.Client {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterPhpServiceServer(srv, svc)
+	healthSrv := health.NewServer()
+	healthSrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthSrv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+
+	client, err := tokiophp.NewClient(context.Background(), "bufconn",
+		tokiophp.WithDialOption(grpc.WithContextDialer(dialer)),
+		tokiophp.WithDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+	)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+func TestGateway_Execute(t *testing.T) {
+	client := dialFakeClient(t, fakePhpService{})
+	srv := httptest.NewServer(newMux(client))
+	t.Cleanup(srv.Close)
+
+	body := `{"script_path":"index.php","method":"GET"}`
+	resp, err := http.Post(srv.URL+"/execute", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST /execute: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var got executeHTTPResponse
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.StatusCode != 200 || string(got.Body) != "script=index.php" {
+		t.Errorf("got %+v", got)
+	}
+}
+
+func TestGateway_Health(t *testing.T) {
+	client := dialFakeClient(t, fakePhpService{})
+	srv := httptest.NewServer(newMux(client))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/health", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /health: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	var got map[string]string
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got["status"] != "SERVING" {
+		t.Errorf("status = %q, want %q", got["status"], "SERVING")
+	}
+}
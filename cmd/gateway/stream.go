@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	tokiophp "github.com/petstack/tokio_php/clients/go"
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+const streamReadChunkSize = 32 * 1024
+
+// handleExecuteStream proxies POST /execute/stream onto PhpService's
+// ExecuteStream RPC: the HTTP request body is piped into the gRPC
+// request stream as it's read, and the gRPC response stream is copied to
+// the HTTP response as chunked transfer-encoding, so neither side
+// buffers the whole body. The script's status code and headers, only
+// known once it finishes, are sent as HTTP trailers.
+func handleExecuteStream(client *tokiophp.Client) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		meta := &pb.ExecuteRequest{
+			ScriptPath:  r.URL.Query().Get("script_path"),
+			Method:      r.Method,
+			QueryParams: flattenQuery(r.URL.Query()),
+		}
+
+		result, err := client.ExecuteStream(r.Context(), meta, r.Body)
+		if err != nil {
+			encodeError(r.Context(), err, w)
+			return
+		}
+
+		w.Header().Set("Trailer", "X-Php-Status, X-Php-Headers")
+		w.WriteHeader(http.StatusOK)
+
+		flusher, canFlush := w.(http.Flusher)
+		buf := make([]byte, streamReadChunkSize)
+		for {
+			n, readErr := result.Body.Read(buf)
+			if n > 0 {
+				if _, writeErr := w.Write(buf[:n]); writeErr != nil {
+					return
+				}
+				if canFlush {
+					flusher.Flush()
+				}
+			}
+			if readErr != nil {
+				break
+			}
+		}
+
+		trailer, err := result.Wait()
+		if err != nil || trailer == nil {
+			return
+		}
+		w.Header().Set("X-Php-Status", strconv.Itoa(int(trailer.GetStatusCode())))
+		if headers, err := json.Marshal(trailer.GetHeaders()); err == nil {
+			w.Header().Set("X-Php-Headers", string(headers))
+		}
+	}
+}
+
+// flattenQuery takes the first value of each query parameter, matching
+// the single-valued map tokio_php's ExecuteRequest.QueryParams expects.
+func flattenQuery(values map[string][]string) map[string]string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		if len(v) > 0 {
+			out[k] = v[0]
+		}
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
+)
+
+func TestGateway_ExecuteStream(t *testing.T) {
+	svc := fakePhpService{
+		executeStream: func(stream pb.PhpService_ExecuteStreamServer) error {
+			first, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			if first.GetMetadata().GetScriptPath() != "upload.php" {
+				t.Errorf("ScriptPath = %q, want %q", first.GetMetadata().GetScriptPath(), "upload.php")
+			}
+
+			var body strings.Builder
+			for {
+				msg, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					return err
+				}
+				body.Write(msg.GetBodyChunk())
+			}
+
+			if err := stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_BodyChunk{BodyChunk: []byte("echo:" + body.String())},
+			}); err != nil {
+				return err
+			}
+			return stream.Send(&pb.ExecuteStreamResponse{
+				Payload: &pb.ExecuteStreamResponse_Trailer{Trailer: &pb.Trailer{
+					StatusCode: 201,
+					Headers:    map[string]string{"X-Test": "yes"},
+				}},
+			})
+		},
+	}
+	client := dialFakeClient(t, svc)
+	srv := httptest.NewServer(newMux(client))
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, srv.URL+"/execute/stream?script_path=upload.php", strings.NewReader("hello body"))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /execute/stream: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if resp.TransferEncoding == nil || resp.TransferEncoding[0] != "chunked" {
+		t.Errorf("TransferEncoding = %v, want chunked", resp.TransferEncoding)
+	}
+
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	if string(got) != "echo:hello body" {
+		t.Errorf("body = %q, want %q", got, "echo:hello body")
+	}
+
+	if status := resp.Trailer.Get("X-Php-Status"); status != "201" {
+		t.Errorf("X-Php-Status trailer = %q, want %q", status, "201")
+	}
+	if headers := resp.Trailer.Get("X-Php-Headers"); !strings.Contains(headers, `"X-Test":"yes"`) {
+		t.Errorf("X-Php-Headers trailer = %q, want to contain X-Test", headers)
+	}
+}
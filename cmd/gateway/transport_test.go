@@ -0,0 +1,36 @@
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPStatusFromGRPC(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.OK, http.StatusOK},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unknown, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			err := status.Error(tt.code, "test")
+			if got := httpStatusFromGRPC(err); got != tt.want {
+				t.Errorf("httpStatusFromGRPC(%v) = %d, want %d", tt.code, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+// executeHTTPRequest is the JSON body POST /execute accepts. It mirrors
+// pb.ExecuteRequest/pb.RequestOptions field-for-field so callers that
+// can't host a gRPC client (curl, browsers, legacy PHP callers) can still
+// drive tokio_php.
+type executeHTTPRequest struct {
+	ScriptPath  string              `json:"script_path"`
+	Method      string              `json:"method"`
+	QueryParams map[string]string   `json:"query_params,omitempty"`
+	Body        []byte              `json:"body,omitempty"`
+	Options     *httpRequestOptions `json:"options,omitempty"`
+}
+
+type httpRequestOptions struct {
+	TimeoutMs uint32            `json:"timeout_ms,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+}
+
+// executeHTTPResponse is the JSON body POST /execute returns.
+type executeHTTPResponse struct {
+	StatusCode uint32            `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       []byte            `json:"body,omitempty"`
+}
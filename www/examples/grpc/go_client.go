@@ -1,101 +1,82 @@
-// Package main demonstrates a Go gRPC client for tokio_php.
-//
-// Requirements:
-//
-//	go install google.golang.org/protobuf/cmd/protoc-gen-go@latest
-//	go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@latest
-//
-// Generate proto classes:
-//
-//	protoc --go_out=. --go-grpc_out=. -I../../../proto ../../../proto/php_service.proto
+// Command go_client is a minimal example of calling tokio_php from Go using
+// the official SDK at github.com/petstack/tokio_php/clients/go.
 //
 // Usage:
 //
 //	go run go_client.go
+//
+// Pass -health-probe to use it as a Kubernetes grpc_health_probe
+// replacement instead: it calls the standard gRPC health check and exits
+// 0 if the server reports SERVING, 1 otherwise.
 package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"time"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	tokiophp "github.com/petstack/tokio_php/clients/go"
+	pb "github.com/petstack/tokio_php/proto/gen/go/tokio_php/v1"
 )
 
 func main() {
+	healthProbe := flag.Bool("health-probe", false, "check server health and exit, instead of running the example request")
+	healthService := flag.String("health-service", "", "service name to check with -health-probe (empty means the server as a whole)")
+	flag.Parse()
+
 	host := os.Getenv("GRPC_HOST")
 	if host == "" {
 		host = "localhost:50051"
 	}
 
-	fmt.Println("=== tokio_php Go gRPC Client ===")
-	fmt.Printf("Connecting to: %s\n\n", host)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
 
-	// Connect to gRPC server
-	conn, err := grpc.NewClient(host,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	client, err := tokiophp.NewClient(ctx, host)
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
-	defer conn.Close()
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
+	defer client.Close()
 
-	// Note: In production, import generated proto classes
-	// For this example, we show the request structure
-
-	fmt.Println("Go gRPC client structure:")
-	fmt.Println(`
-// Import generated proto
-import pb "github.com/your-org/tokio-php-client/proto"
-
-// Create client
-client := pb.NewPhpServiceClient(conn)
-
-// Execute request
-req := &pb.ExecuteRequest{
-    ScriptPath:  "index.php",
-    Method:      "GET",
-    QueryParams: map[string]string{"page": "1"},
-    Options: &pb.RequestOptions{
-        TimeoutMs: 5000,
-    },
-}
+	if *healthProbe {
+		runHealthProbe(ctx, client, *healthService)
+		return
+	}
 
-// Call service
-resp, err := client.Execute(ctx, req)
-if err != nil {
-    log.Fatal(err)
-}
+	fmt.Println("=== tokio_php Go gRPC Client ===")
+	fmt.Printf("Connecting to: %s\n\n", host)
 
-fmt.Printf("Status: %d\n", resp.StatusCode)
-fmt.Printf("Body: %s\n", string(resp.Body))
-`)
+	resp, err := client.Execute(ctx, &pb.ExecuteRequest{
+		ScriptPath:  "index.php",
+		Method:      "GET",
+		QueryParams: map[string]string{"page": "1"},
+		Options:     tokiophp.NewRequestOptions().WithTimeout(5 * time.Second).Build(),
+	})
+	if err != nil {
+		log.Fatalf("Execute failed: %v", err)
+	}
 
-	// Raw example (without generated code)
-	fmt.Println("\nRaw gRPC example (without proto generation):")
-	rawExample(ctx, conn)
+	fmt.Printf("Status: %d\n", resp.GetStatusCode())
+	fmt.Printf("Body: %s\n", resp.GetBody())
 }
 
-func rawExample(ctx context.Context, conn *grpc.ClientConn) {
-	// This demonstrates the structure without generated code
-	// In production, always use protoc-generated classes
-
-	fmt.Println(`
-// Health check (raw)
-stream, err := conn.NewStream(ctx, &grpc.StreamDesc{
-    StreamName: "Check",
-}, "/tokio_php.v1.PhpService/Check")
+// runHealthProbe checks service's health and exits 0/1 like
+// grpc_health_probe, so this binary can be dropped into a Kubernetes
+// livenessProbe/readinessProbe exec check.
+func runHealthProbe(ctx context.Context, client *tokiophp.Client, service string) {
+	status, err := client.HealthCheck(ctx, service)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "health check failed: %v\n", err)
+		os.Exit(1)
+	}
 
-// Execute (raw)
-stream, err := conn.NewStream(ctx, &grpc.StreamDesc{
-    StreamName: "Execute",
-}, "/tokio_php.v1.PhpService/Execute")
-`)
+	fmt.Println(status)
+	if status != healthpb.HealthCheckResponse_SERVING {
+		os.Exit(1)
+	}
 }